@@ -0,0 +1,117 @@
+package binaryfield
+
+// MulBatch computes dst[i] = a[i]*b[i] mod f(X) for every i, as a convenience
+// over calling Mul in a loop. a, b and dst must all have the same length.
+func (f *Field) MulBatch(dst, a, b []*Element) {
+	if len(a) != len(b) || len(dst) != len(a) {
+		panic("binaryfield: MulBatch requires dst, a and b to have equal length")
+	}
+	for i := range a {
+		dst[i] = f.Mul(a[i], b[i])
+	}
+}
+
+// InnerProduct returns sum(a[i]*b[i]) mod f(X). Unlike calling Mul and Add in
+// a loop, the individual products are accumulated unreduced (XOR, which is
+// exactly addition in GF(2)[X], commutes with taking the remainder mod f(X))
+// and only reduced once at the end.
+func (f *Field) InnerProduct(a, b []*Element) *Element {
+	if len(a) != len(b) {
+		panic("binaryfield: InnerProduct requires a and b to have equal length")
+	}
+	acc := make([]uint64, 2*f.Words)
+	for i := range a {
+		f.checkOperand(a[i], "a")
+		f.checkOperand(b[i], "b")
+		p := f.mulComb(a[i].words, b[i].words)
+		for w := range acc {
+			acc[w] ^= p[w]
+		}
+	}
+	return &Element{f: f, words: f.Reduce(acc)}
+}
+
+// Multiplier amortizes repeated multiplication by a fixed right-hand operand
+// b across many left-hand operands -- the pattern coinbase/kryptology's KOS
+// OT extension needs, computing thousands of GF(2^kappa) products against a
+// shared operand per protocol round. It precomputes a windowed table of the
+// 16 possible 4-bit-window multiples of b (Hankerson, Menezes and Vanstone,
+// Algorithm 2.36, "Left-to-right comb method with windows of width w", with
+// w=4), turning each subsequent multiplication into t*16 table lookups and
+// XORs instead of t*64 masked-XOR steps.
+type Multiplier struct {
+	f     *Field
+	table [16][]uint64 // table[u] = (u0 + u1*X + u2*X^2 + u3*X^3) * b, unreduced
+}
+
+// NewMultiplier builds a Multiplier for repeated multiplication by b.
+func NewMultiplier(f *Field, b *Element) *Multiplier {
+	f.checkOperand(b, "b")
+	m := &Multiplier{f: f}
+	for u := 0; u < 16; u++ {
+		entry := make([]uint64, f.Words+1)
+		for bit := 0; bit < 4; bit++ {
+			if u>>uint(bit)&1 == 0 {
+				continue
+			}
+			shifted := shiftLeftSmall(b.words, bit)
+			for i := range entry {
+				entry[i] ^= shifted[i]
+			}
+		}
+		m.table[u] = entry
+	}
+	return m
+}
+
+// Mul returns a*b mod f(X), where b is the operand this Multiplier was built
+// for.
+func (m *Multiplier) Mul(a *Element) *Element {
+	f := m.f
+	f.checkOperand(a, "a")
+	acc := make([]uint64, 2*f.Words)
+	for k := f.Words*16 - 1; k >= 0; k-- {
+		shiftLeftInPlace4(acc)
+		entry := m.table[nibbleAt(a.words, k)]
+		for i := range entry {
+			acc[i] ^= entry[i]
+		}
+	}
+	return &Element{f: f, words: f.Reduce(acc)}
+}
+
+// shiftLeftSmall returns words shifted left by s bits (0 <= s < 64), growing
+// by one word to hold the overflow.
+func shiftLeftSmall(words []uint64, s int) []uint64 {
+	out := make([]uint64, len(words)+1)
+	if s == 0 {
+		copy(out, words)
+		return out
+	}
+	var carry uint64
+	for i, w := range words {
+		out[i] = w<<uint(s) | carry
+		carry = w >> uint(64-s)
+	}
+	out[len(words)] = carry
+	return out
+}
+
+// shiftLeftInPlace4 shifts words left by 4 bits in place; any overflow past
+// the end of words is discarded, since callers size their accumulator with
+// enough headroom to never lose significant bits.
+func shiftLeftInPlace4(words []uint64) {
+	var carry uint64
+	for i := range words {
+		next := words[i] >> 60
+		words[i] = words[i]<<4 | carry
+		carry = next
+	}
+}
+
+// nibbleAt returns the 4-bit value at bit offset 4*k in words. Since 64 is a
+// multiple of 4, a nibble never spans a word boundary.
+func nibbleAt(words []uint64, k int) int {
+	bit := 4 * k
+	return int(words[bit>>6] >> uint(bit&63) & 0xF)
+}
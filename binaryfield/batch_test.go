@@ -0,0 +1,101 @@
+package binaryfield
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func randomElement(f *Field) *Element {
+	data := make([]byte, f.ByteLen())
+	_, _ = rand.Read(data)
+	return NewElement(f, data)
+}
+
+func TestMulBatchMatchesMul(t *testing.T) {
+	f := Field256
+	const n = 32
+	a := make([]*Element, n)
+	b := make([]*Element, n)
+	for i := range a {
+		a[i] = randomElement(f)
+		b[i] = randomElement(f)
+	}
+	dst := make([]*Element, n)
+	f.MulBatch(dst, a, b)
+	for i := range a {
+		want := f.Mul(a[i], b[i])
+		if !bytes.Equal(dst[i].Bytes(), want.Bytes()) {
+			t.Fatalf("MulBatch[%d] = %x, want %x", i, dst[i].Bytes(), want.Bytes())
+		}
+	}
+}
+
+func TestInnerProductMatchesLoop(t *testing.T) {
+	f := Field163
+	const n = 16
+	a := make([]*Element, n)
+	b := make([]*Element, n)
+	for i := range a {
+		a[i] = randomElement(f)
+		b[i] = randomElement(f)
+	}
+	got := f.InnerProduct(a, b)
+
+	want := NewElement(f, make([]byte, f.ByteLen()))
+	for i := range a {
+		want = f.Add(want, f.Mul(a[i], b[i]))
+	}
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatalf("InnerProduct = %x, want %x", got.Bytes(), want.Bytes())
+	}
+}
+
+func TestMultiplierMatchesMul(t *testing.T) {
+	for _, f := range []*Field{Field163, Field233, Field256, Field283, Field409, Field571} {
+		b := randomElement(f)
+		m := NewMultiplier(f, b)
+		for i := 0; i < 8; i++ {
+			a := randomElement(f)
+			got := m.Mul(a)
+			want := f.Mul(a, b)
+			if !bytes.Equal(got.Bytes(), want.Bytes()) {
+				t.Fatalf("%v: Multiplier.Mul = %x, want %x", f, got.Bytes(), want.Bytes())
+			}
+		}
+	}
+}
+
+func BenchmarkMulLoop256(b *testing.B) {
+	const n = 256
+	f := Field256
+	as := make([]*Element, n)
+	bs := make([]*Element, n)
+	for i := range as {
+		as[i] = randomElement(f)
+		bs[i] = randomElement(f)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range as {
+			f.Mul(as[j], bs[j])
+		}
+	}
+}
+
+func BenchmarkMultiplierFixedOperand256(b *testing.B) {
+	const n = 256
+	f := Field256
+	shared := randomElement(f)
+	as := make([]*Element, n)
+	for i := range as {
+		as[i] = randomElement(f)
+	}
+	m := NewMultiplier(f, shared)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range as {
+			m.Mul(as[j])
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Package binaryfield implements arithmetic in binary extension fields GF(2^m),
+// parameterized by the extension degree m and an irreducible (over F_2) reduction
+// polynomial f(X) = X^m + X^(tail[0]) + ... + X^(tail[k-1]) + 1.
+//
+// The reference is Hankerson, Menezes and Vanstone, Guide to Elliptic Curve
+// Cryptography (https://link.springer.com/book/10.1007/b97644). Multiplication
+// uses Algorithm 2.34, "Right-to-left comb method for polynomial multiplication",
+// and reduction follows the idea in section 2.3.9: a word at a time when m is
+// a multiple of 64, bit-serially otherwise.
+package binaryfield
+
+import "fmt"
+
+// wordBits is the machine word width, in bits, used to store field elements.
+const wordBits = 64
+
+// Field describes a binary extension field GF(2^M), reducing modulo
+// f(X) = X^M + sum(X^d for d in Tail) (Tail always implicitly includes the
+// constant term, i.e. the last entry of Tail is 0).
+type Field struct {
+	M     int   // extension degree
+	Tail  []int // exponents of the reduction polynomial below X^M, descending, ending in 0
+	Words int   // ceil(M/64), the number of uint64 words used to hold an Element
+}
+
+// NewField builds a Field for GF(2^m) reducing modulo X^m + sum(X^d for d in tail).
+// tail must be given in strictly descending order and its last entry must be 0
+// (the constant term of every irreducible polynomial used here).
+func NewField(m int, tail []int) *Field {
+	if len(tail) == 0 || tail[len(tail)-1] != 0 {
+		panic("binaryfield: reduction polynomial tail must end in the constant term 0")
+	}
+	for i, d := range tail {
+		if d < 0 || d >= m {
+			panic("binaryfield: reduction polynomial tail exponent out of range")
+		}
+		if i > 0 && tail[i-1] <= d {
+			panic("binaryfield: reduction polynomial tail must be strictly descending")
+		}
+	}
+	return &Field{
+		M:     m,
+		Tail:  tail,
+		Words: (m + wordBits - 1) / wordBits,
+	}
+}
+
+// ByteLen is the number of bytes used to hold an Element of f, i.e. 8*Words.
+func (f *Field) ByteLen() int {
+	return f.Words * 8
+}
+
+// Preset fields for the pentanomials and trinomials of Hankerson, Menezes and
+// Vanstone, Table A.1, plus the GF(2^256) field this package originally hardcoded.
+var (
+	// Field128 is GF(2^128), f(X) = X^128 + X^7 + X^2 + X + 1, the field
+	// GHASH (NIST SP 800-38D) performs its multiplications in.
+	Field128 = NewField(128, []int{7, 2, 1, 0})
+	// Field163 is GF(2^163), f(X) = X^163 + X^7 + X^6 + X^3 + 1.
+	Field163 = NewField(163, []int{7, 6, 3, 0})
+	// Field233 is GF(2^233), f(X) = X^233 + X^74 + 1.
+	Field233 = NewField(233, []int{74, 0})
+	// Field256 is GF(2^256), f(X) = X^256 + X^10 + X^5 + X^2 + 1.
+	// This is the field the original binaryFieldMul hardcoded.
+	Field256 = NewField(256, []int{10, 5, 2, 0})
+	// Field283 is GF(2^283), f(X) = X^283 + X^12 + X^7 + X^5 + 1.
+	Field283 = NewField(283, []int{12, 7, 5, 0})
+	// Field409 is GF(2^409), f(X) = X^409 + X^87 + 1.
+	Field409 = NewField(409, []int{87, 0})
+	// Field571 is GF(2^571), f(X) = X^571 + X^10 + X^5 + X^2 + 1.
+	Field571 = NewField(571, []int{10, 5, 2, 0})
+)
+
+// Element is a constant-time field element of some Field, stored as a
+// little-endian vector of words: bit i of the polynomial's coefficients is
+// words[i>>6] >> (i&63) & 1.
+type Element struct {
+	f     *Field
+	words []uint64
+}
+
+// NewElement builds an Element of f from a little-endian byte slice, as in the
+// original binaryFieldMul: data[i] holds bits 8i..8i+7 of the polynomial's
+// coefficients. len(data) must equal f.ByteLen(). Any bits at or above X^M in
+// the top word are discarded, so the result is always canonical even when M
+// isn't a multiple of the word size (e.g. Field163, Field233, Field283,
+// Field409 and Field571).
+func NewElement(f *Field, data []byte) *Element {
+	if len(data) != f.ByteLen() {
+		panic(fmt.Sprintf("binaryfield: expected %d bytes, got %d", f.ByteLen(), len(data)))
+	}
+	e := &Element{f: f, words: make([]uint64, f.Words)}
+	for i, b := range data {
+		e.words[i>>3] |= uint64(b) << ((i & 0x07) << 3)
+	}
+	if spare := uint(f.Words*wordBits - f.M); spare > 0 {
+		e.words[f.Words-1] &^= ^uint64(0) << (wordBits - spare)
+	}
+	return e
+}
+
+// Bytes returns the little-endian byte representation of e.
+func (e *Element) Bytes() []byte {
+	out := make([]byte, e.f.ByteLen())
+	for i := range out {
+		out[i] = byte(e.words[i>>3] >> ((i & 0x07) << 3))
+	}
+	return out
+}
+
+// Field returns the Field that e belongs to.
+func (e *Element) Field() *Field {
+	return e.f
+}
+
+func (f *Field) newZero() *Element {
+	return &Element{f: f, words: make([]uint64, f.Words)}
+}
+
+func (f *Field) checkOperand(e *Element, name string) {
+	if e.f != f {
+		panic(fmt.Sprintf("binaryfield: %s belongs to a different Field", name))
+	}
+}
+
+// Add returns a+b, which in characteristic 2 is simply the bitwise XOR of
+// their coefficient vectors.
+func (f *Field) Add(a, b *Element) *Element {
+	f.checkOperand(a, "a")
+	f.checkOperand(b, "b")
+	c := f.newZero()
+	for i := range c.words {
+		c.words[i] = a.words[i] ^ b.words[i]
+	}
+	return c
+}
+
+// Mul returns a*b mod f(X). On amd64 with PCLMULQDQ available, Field256
+// multiplications are carried out in hardware; see mul_amd64.go.
+func (f *Field) Mul(a, b *Element) *Element {
+	f.checkOperand(a, "a")
+	f.checkOperand(b, "b")
+	if f == Field256 && hasCLMUL {
+		return mulField256CLMUL(a, b)
+	}
+	return &Element{f: f, words: f.Reduce(f.mulComb(a.words, b.words))}
+}
+
+// mulComb multiplies two Words-word polynomials using Algorithm 2.34,
+// "Right-to-left comb method for polynomial multiplication", and returns the
+// unreduced 2*Words-word product.
+func (f *Field) mulComb(a, b []uint64) []uint64 {
+	t := f.Words
+	c := make([]uint64, 2*t)
+	bShift := make([]uint64, t+1) // b, shifted left by the current amount
+	copy(bShift, b)
+	for k := 0; k < wordBits; k++ {
+		for j := 0; j < t; j++ {
+			// Conditionally add a copy of (the appropriately shifted) b to c,
+			// depending on the appropriate bit of a. This is done in
+			// constant-time, i.e. independent of a.
+			mask := -(a[j] >> k & 0x01)
+			for i := 0; i < t+1; i++ {
+				c[j+i] ^= bShift[i] & mask
+			}
+		}
+		for i := t; i > 0; i-- {
+			bShift[i] = bShift[i]<<1 | bShift[i-1]>>63
+		}
+		bShift[0] <<= 1
+	}
+	return c
+}
+
+// Reduce takes the 2*Words-word unreduced product c and reduces it modulo
+// f(X), returning a Words-word result. When M is a multiple of the word size
+// (Field256, the field this package originally hardcoded), the high words
+// line up exactly with the X^M substitution and reduceWordAligned folds them
+// down a word at a time. Otherwise it falls back to reduceBitSerial, which
+// handles an arbitrary M and Tail but a bit at a time.
+func (f *Field) Reduce(c []uint64) []uint64 {
+	if f.M%wordBits == 0 {
+		return f.reduceWordAligned(c)
+	}
+	return f.reduceBitSerial(c)
+}
+
+// reduceWordAligned reduces c for fields whose degree M is an exact multiple
+// of the word size. Word c[i] (i >= Words) holds the coefficients of
+// X^(64i)..X^(64i+63); since X^(64i) = X^(64(i-Words)) * X^M =
+// X^(64(i-Words)) * sum(X^d for d in Tail), c[i]'s contribution folds into
+// words (i-Words) and (i-Words)+1 for every tail term d, exactly as the
+// original hardcoded X^256+X^10+X^5+X^2+1 reduction did for its four terms --
+// generalized here to run off f.Tail instead of fixed shift amounts.
+func (f *Field) reduceWordAligned(c []uint64) []uint64 {
+	t := f.Words
+	for i := len(c) - 1; i >= t; i-- {
+		word := c[i]
+		c[i] = 0
+		base := (i - t) * wordBits
+		for _, d := range f.Tail {
+			xorShiftedWord(c, word, base+d)
+		}
+	}
+	return c[:t]
+}
+
+// xorShiftedWord XORs word, shifted left by bitShift bits (which may be any
+// non-negative amount, not just 0..63), into dst. The shifted value spans at
+// most two words of dst.
+func xorShiftedWord(dst []uint64, word uint64, bitShift int) {
+	wordIdx := bitShift / wordBits
+	bitOff := uint(bitShift % wordBits)
+	if bitOff == 0 {
+		dst[wordIdx] ^= word
+		return
+	}
+	dst[wordIdx] ^= word << bitOff
+	if wordIdx+1 < len(dst) {
+		dst[wordIdx+1] ^= word >> (wordBits - bitOff)
+	}
+}
+
+// reduceBitSerial walks the bits of c from the highest degree term down to
+// X^M, and whenever a bit at position i >= M is set, replaces it with the
+// (lower-degree) tail of f(X) shifted by i-M -- mirroring the idea of Figure
+// 2.9, generalized from fixed word-aligned shifts to an arbitrary extension
+// degree and tail. It is used for fields whose M isn't a multiple of the word
+// size, where reduceWordAligned's word-level folding doesn't apply.
+func (f *Field) reduceBitSerial(c []uint64) []uint64 {
+	for i := len(c)*wordBits - 1; i >= f.M; i-- {
+		bit := c[i>>6] >> (uint(i) & 63) & 1
+		c[i>>6] &^= uint64(1) << (uint(i) & 63)
+		for _, d := range f.Tail {
+			j := i - f.M + d
+			c[j>>6] ^= bit << (uint(j) & 63)
+		}
+	}
+	return c[:f.Words]
+}
+
+// Pow returns a^e mod f(X), where e is a little-endian byte exponent (the
+// same representation as Element), using left-to-right square-and-multiply.
+// The sequence of squarings and multiplications performed does not depend on
+// the value of e, only on its length, so this runs in constant time for a
+// fixed exponent size.
+func (f *Field) Pow(a *Element, e []byte) *Element {
+	f.checkOperand(a, "a")
+	result := NewElement(f, make([]byte, f.ByteLen()))
+	result.words[0] = 1
+	for i := len(e)*8 - 1; i >= 0; i-- {
+		result = f.Square(result)
+		bit := e[i>>3] >> uint(i&0x07) & 1
+		mask := -uint64(bit)
+		multiplied := f.Mul(result, a)
+		for w := range result.words {
+			result.words[w] = result.words[w]&^mask | multiplied.words[w]&mask
+		}
+	}
+	return result
+}
+
+// Square and Inv are implemented in itoh_tsujii.go.
@@ -0,0 +1,65 @@
+package binaryfield
+
+// Square returns a*a mod f(X).
+//
+// Squaring a binary polynomial is cheap: (sum a_i X^i)^2 = sum a_i X^(2i),
+// since all the cross terms have even coefficients and vanish mod 2. So
+// instead of running the general comb multiplier, we insert a zero bit
+// between every coefficient of a (bit-interleaving) and reduce the result,
+// which is exactly the X^(2i) substitution above.
+func (f *Field) Square(a *Element) *Element {
+	f.checkOperand(a, "a")
+	wide := make([]uint64, 2*f.Words)
+	for i := 0; i < f.Words*wordBits; i++ {
+		bit := a.words[i>>6] >> uint(i&63) & 1
+		j := 2 * i
+		wide[j>>6] |= bit << uint(j&63)
+	}
+	return &Element{f: f, words: f.Reduce(wide)}
+}
+
+// frobeniusPow returns a^(2^k), i.e. a squared k times. Named for the
+// Frobenius endomorphism x -> x^2 of GF(2^M), whose k-fold composition this
+// computes.
+func (f *Field) frobeniusPow(a *Element, k int) *Element {
+	for i := 0; i < k; i++ {
+		a = f.Square(a)
+	}
+	return a
+}
+
+// addChainPow2MinusOne returns a^(2^n-1) using the Itoh-Tsujii addition
+// chain: b_1 = a, and for the recursive doubling step
+//
+//	b_(2k)   = b_k * b_k^(2^k)          (= a^(2^(2k)-1))
+//	b_(2k+1) = (b_(2k))^2 * a           (= a^(2^(2k+1)-1))
+//
+// This needs O(log n) field multiplications (one per recursive level, plus
+// one more at each level where n is odd) plus
+// O(n) squarings, instead of the O(n) multiplications a naive
+// square-and-multiply chain for the same exponent would take.
+func (f *Field) addChainPow2MinusOne(a *Element, n int) *Element {
+	if n == 1 {
+		return a
+	}
+	half := n / 2
+	b := f.addChainPow2MinusOne(a, half)
+	doubled := f.Mul(b, f.frobeniusPow(b, half))
+	if n%2 == 0 {
+		return doubled
+	}
+	return f.Mul(f.Square(doubled), a)
+}
+
+// Inv returns the multiplicative inverse of a, via Fermat's little theorem
+// for GF(2^M): a^(2^M) = a, so a^(2^M-1) = 1 and a^(2^M-2) = a^-1 for any
+// nonzero a. Since a^(2^M-2) = (a^(2^(M-1)-1))^2, this reduces inversion to
+// one addChainPow2MinusOne call (an Itoh-Tsujii addition chain, O(log M)
+// multiplications) followed by a single squaring. For M=256 this decomposes
+// 255 = 2*127+1 = 2*(2*63+1)+1 = ... down through 127, 63, 31, 15, 7, 3, 1;
+// each of the seven odd levels 255..3 costs two multiplications, i.e. 14
+// multiplications plus 255 squarings in total.
+func (f *Field) Inv(a *Element) *Element {
+	f.checkOperand(a, "a")
+	return f.Square(f.addChainPow2MinusOne(a, f.M-1))
+}
@@ -0,0 +1,37 @@
+package binaryfield
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+func TestSquareMatchesMul(t *testing.T) {
+	for _, f := range []*Field{Field163, Field233, Field256, Field283, Field409, Field571} {
+		data := make([]byte, f.ByteLen())
+		_, _ = rand.Read(data)
+		a := NewElement(f, data)
+		viaBitInterleave := f.Square(a)
+		viaMul := f.Mul(a, a)
+		if !bytes.Equal(viaBitInterleave.Bytes(), viaMul.Bytes()) {
+			t.Fatalf("%v: Square(a) = %x, Mul(a,a) = %x", f, viaBitInterleave.Bytes(), viaMul.Bytes())
+		}
+	}
+}
+
+func TestPowMatchesRepeatedSquaring(t *testing.T) {
+	// a^(2^8) should equal eight successive calls to Square, for any field.
+	f := Field571
+	data := make([]byte, f.ByteLen())
+	_, _ = rand.Read(data)
+	a := NewElement(f, data)
+
+	exp := make([]byte, f.ByteLen())
+	exp[1] = 1 // bit 8 set, i.e. exp == 2^8
+
+	want := f.frobeniusPow(a, 8)
+	got := f.Pow(a, exp)
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Fatalf("a^(2^8) = %x, want %x", got.Bytes(), want.Bytes())
+	}
+}
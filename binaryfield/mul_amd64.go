@@ -0,0 +1,28 @@
+//go:build amd64
+
+package binaryfield
+
+import "golang.org/x/sys/cpu"
+
+// hasCLMUL reports whether the PCLMULQDQ carry-less multiply instruction is
+// available on this CPU.
+var hasCLMUL = cpu.X86.HasPCLMULQDQ
+
+// mulCLMUL computes the unreduced 512-bit carry-less product of two 256-bit
+// polynomials, each given as four little-endian 64-bit words, using the
+// PCLMULQDQ instruction. Implemented in mul_amd64.s.
+//
+//go:noescape
+func mulCLMUL(c *[8]uint64, a, b *[4]uint64)
+
+// mulField256CLMUL multiplies two Field256 elements using mulCLMUL, then
+// reduces with the same Reduce used by the pure-Go path. It assumes exactly
+// four input words and so is only valid for Field256.
+func mulField256CLMUL(a, b *Element) *Element {
+	var aw, bw [4]uint64
+	copy(aw[:], a.words)
+	copy(bw[:], b.words)
+	var cw [8]uint64
+	mulCLMUL(&cw, &aw, &bw)
+	return &Element{f: Field256, words: Field256.Reduce(cw[:])}
+}
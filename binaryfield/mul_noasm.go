@@ -0,0 +1,11 @@
+//go:build !amd64
+
+package binaryfield
+
+// hasCLMUL is always false outside amd64: there is no PCLMULQDQ backend to
+// dispatch to, so Mul always takes the pure-Go comb-method path.
+var hasCLMUL = false
+
+func mulField256CLMUL(a, b *Element) *Element {
+	panic("binaryfield: mulField256CLMUL is only available on amd64")
+}
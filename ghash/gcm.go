@@ -0,0 +1,134 @@
+package ghash
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+)
+
+const (
+	nonceSize = 12
+	tagSize   = 16
+)
+
+type gcm struct {
+	cipher cipher.Block
+}
+
+// NewGCM wraps block, a 128-bit block cipher, in a cipher.AEAD that
+// authenticates using this package's GHASH instead of crypto/cipher's
+// internal implementation, so this module's field arithmetic can be
+// benchmarked and cross-checked against crypto/cipher.NewGCM. Only the
+// standard 96-bit nonce size is supported.
+func NewGCM(block cipher.Block) (cipher.AEAD, error) {
+	if block.BlockSize() != tagSize {
+		return nil, errors.New("ghash: NewGCM requires a 128-bit block cipher")
+	}
+	return &gcm{cipher: block}, nil
+}
+
+func (g *gcm) NonceSize() int { return nonceSize }
+func (g *gcm) Overhead() int  { return tagSize }
+
+func (g *gcm) hashKey() [16]byte {
+	var h [16]byte
+	g.cipher.Encrypt(h[:], h[:])
+	return h
+}
+
+func (g *gcm) j0(nonce []byte) [16]byte {
+	var block [16]byte
+	copy(block[:], nonce)
+	block[15] = 1
+	return block
+}
+
+func incrementCounter(block *[16]byte) {
+	for i := 15; i >= 12; i-- {
+		block[i]++
+		if block[i] != 0 {
+			break
+		}
+	}
+}
+
+// gctr implements the GCTR function from NIST SP 800-38D section 6.5: encrypt
+// in with the block cipher's keystream starting at counter icb, incrementing
+// only the low 32 bits of the counter between blocks.
+func (g *gcm) gctr(icb [16]byte, in []byte) []byte {
+	out := make([]byte, len(in))
+	var keystream [16]byte
+	counter := icb
+	for offset := 0; offset < len(in); offset += tagSize {
+		g.cipher.Encrypt(keystream[:], counter[:])
+		end := offset + tagSize
+		if end > len(in) {
+			end = len(in)
+		}
+		for i := offset; i < end; i++ {
+			out[i] = in[i] ^ keystream[i-offset]
+		}
+		incrementCounter(&counter)
+	}
+	return out
+}
+
+func (g *gcm) tag(aad, ciphertext []byte, j0 [16]byte) []byte {
+	h := NewGHASH(g.hashKey())
+	WriteBlock(h, aad)
+	WriteBlock(h, ciphertext)
+	h.Write(LengthBlock(uint64(len(aad))*8, uint64(len(ciphertext))*8))
+	return g.gctr(j0, h.Sum(nil))
+}
+
+func (g *gcm) Seal(dst, nonce, plaintext, additionalData []byte) []byte {
+	if len(nonce) != nonceSize {
+		panic("ghash: incorrect nonce length for NewGCM")
+	}
+	j0 := g.j0(nonce)
+	counter := j0
+	incrementCounter(&counter)
+	ciphertext := g.gctr(counter, plaintext)
+	t := g.tag(additionalData, ciphertext, j0)
+
+	ret, out := sliceForAppend(dst, len(ciphertext)+tagSize)
+	copy(out, ciphertext)
+	copy(out[len(ciphertext):], t)
+	return ret
+}
+
+func (g *gcm) Open(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(nonce) != nonceSize {
+		panic("ghash: incorrect nonce length for NewGCM")
+	}
+	if len(ciphertext) < tagSize {
+		return nil, errors.New("ghash: ciphertext too short")
+	}
+	tagStart := len(ciphertext) - tagSize
+	ct := ciphertext[:tagStart]
+	wantTag := ciphertext[tagStart:]
+
+	j0 := g.j0(nonce)
+	gotTag := g.tag(additionalData, ct, j0)
+	if subtle.ConstantTimeCompare(gotTag, wantTag) != 1 {
+		return nil, errors.New("ghash: message authentication failed")
+	}
+
+	counter := j0
+	incrementCounter(&counter)
+	plaintext := g.gctr(counter, ct)
+	ret, out := sliceForAppend(dst, len(plaintext))
+	copy(out, plaintext)
+	return ret, nil
+}
+
+func sliceForAppend(in []byte, n int) (head, tail []byte) {
+	if total := len(in) + n; cap(in) >= total {
+		head = in[:total]
+	} else {
+		head = make([]byte, total)
+		copy(head, in)
+	}
+	tail = head[len(in):]
+	return
+}
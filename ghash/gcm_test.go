@@ -0,0 +1,79 @@
+package ghash
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"testing"
+)
+
+func TestGCMMatchesStdlib(t *testing.T) {
+	key := make([]byte, 16)
+	nonce := make([]byte, nonceSize)
+	_, _ = rand.Read(key)
+	_, _ = rand.Read(nonce)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		plaintext string
+		aad       string
+	}{
+		{"empty", "", ""},
+		{"aad only", "", "header"},
+		{"short", "hi", ""},
+		{"one block", "sixteen byte msg", "some aad"},
+		{"multi block", "this plaintext spans more than a single sixteen-byte GCM block", "associated data"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			wantCT := want.Seal(nil, nonce, []byte(tc.plaintext), []byte(tc.aad))
+			gotCT := got.Seal(nil, nonce, []byte(tc.plaintext), []byte(tc.aad))
+			if !bytes.Equal(wantCT, gotCT) {
+				t.Fatalf("Seal mismatch:\n  stdlib = %x\n  ghash  = %x", wantCT, gotCT)
+			}
+
+			gotPT, err := got.Open(nil, nonce, wantCT, []byte(tc.aad))
+			if err != nil {
+				t.Fatalf("Open failed: %v", err)
+			}
+			if !bytes.Equal(gotPT, []byte(tc.plaintext)) {
+				t.Fatalf("Open = %q, want %q", gotPT, tc.plaintext)
+			}
+		})
+	}
+}
+
+func TestGCMOpenRejectsTampering(t *testing.T) {
+	key := make([]byte, 16)
+	nonce := make([]byte, nonceSize)
+	_, _ = rand.Read(key)
+	_, _ = rand.Read(nonce)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ct := g.Seal(nil, nonce, []byte("message"), []byte("aad"))
+	ct[0] ^= 0x01
+	if _, err := g.Open(nil, nonce, ct, []byte("aad")); err == nil {
+		t.Fatal("Open accepted a tampered ciphertext")
+	}
+}
@@ -0,0 +1,124 @@
+// Package ghash implements the GHASH universal hash function used by
+// AES-GCM (NIST SP 800-38D, section 6.4), built on this module's GF(2^128)
+// field multiplier (binaryfield.Field128) rather than a table-driven or
+// hardware-specific implementation.
+package ghash
+
+import (
+	"encoding/binary"
+	"hash"
+
+	"github.com/ursa-mikail/binary_field_multiplication/binaryfield"
+)
+
+// BlockSize is the GHASH block size, in bytes: one GF(2^128) element.
+const BlockSize = 16
+
+type ghash struct {
+	h   *binaryfield.Element // the hash subkey H, fixed for the life of the hash
+	acc *binaryfield.Element // running accumulator
+	buf []byte               // bytes of a not-yet-multiplied partial block
+}
+
+// NewGHASH returns a hash.Hash computing GHASH_H for the subkey h. Data
+// written to it is processed in 16-byte blocks: each block is XORed into the
+// running accumulator, then the accumulator is multiplied by H. Per NIST SP
+// 800-38D section 7.1, GHASH's input is the concatenation of the AAD
+// (zero-padded to a block boundary), the ciphertext (likewise padded), and a
+// final block holding both their bit lengths -- callers are expected to
+// write the AAD and ciphertext with WriteBlock (which applies the padding)
+// and finish with the length block built by LengthBlock.
+func NewGHASH(h [16]byte) hash.Hash {
+	return &ghash{
+		h:   binaryfield.NewElement(binaryfield.Field128, reflectBits(h[:])),
+		acc: binaryfield.NewElement(binaryfield.Field128, make([]byte, BlockSize)),
+	}
+}
+
+// Write absorbs p, BlockSize bytes at a time, buffering any remainder for
+// the next Write. p is not implicitly zero-padded; use WriteBlock for AAD or
+// ciphertext that may not be a multiple of BlockSize.
+func (g *ghash) Write(p []byte) (int, error) {
+	n := len(p)
+	g.buf = append(g.buf, p...)
+	for len(g.buf) >= BlockSize {
+		g.absorb(g.buf[:BlockSize])
+		g.buf = g.buf[BlockSize:]
+	}
+	return n, nil
+}
+
+// WriteBlock writes data followed by enough zero bytes to reach a multiple
+// of BlockSize, as NIST SP 800-38D requires for both the AAD and ciphertext
+// inputs to GHASH.
+func WriteBlock(h hash.Hash, data []byte) {
+	h.Write(data)
+	if rem := len(data) % BlockSize; rem != 0 {
+		h.Write(make([]byte, BlockSize-rem))
+	}
+}
+
+// LengthBlock builds the final GHASH input block: the 64-bit big-endian bit
+// length of the AAD followed by the 64-bit big-endian bit length of the
+// ciphertext.
+func LengthBlock(aadBits, ctBits uint64) []byte {
+	block := make([]byte, BlockSize)
+	binary.BigEndian.PutUint64(block[0:8], aadBits)
+	binary.BigEndian.PutUint64(block[8:16], ctBits)
+	return block
+}
+
+func (g *ghash) absorb(block []byte) {
+	x := binaryfield.NewElement(binaryfield.Field128, reflectBits(block))
+	g.acc = binaryfield.Field128.Mul(binaryfield.Field128.Add(g.acc, x), g.h)
+}
+
+// Sum appends the current GHASH digest to b. Per the GHASH definition, Sum
+// should only be called once the full AAD||ciphertext||length-block stream
+// has been written; any buffered partial block is absorbed as a zero-padded
+// final block without mutating the hash's own state.
+func (g *ghash) Sum(b []byte) []byte {
+	acc := g.acc
+	if len(g.buf) > 0 {
+		var padded [BlockSize]byte
+		copy(padded[:], g.buf)
+		x := binaryfield.NewElement(binaryfield.Field128, reflectBits(padded[:]))
+		acc = binaryfield.Field128.Mul(binaryfield.Field128.Add(acc, x), g.h)
+	}
+	return append(b, unreflectBits(acc.Bytes())...)
+}
+
+func (g *ghash) Reset() {
+	g.acc = binaryfield.NewElement(binaryfield.Field128, make([]byte, BlockSize))
+	g.buf = nil
+}
+
+func (g *ghash) Size() int      { return BlockSize }
+func (g *ghash) BlockSize() int { return BlockSize }
+
+// reflectBits converts a 16-byte GCM block between its NIST SP 800-38D
+// convention (within each byte, the leftmost/most-significant bit is the
+// coefficient of the lowest power of X in that byte's term) and
+// binaryfield.Element's convention (bit i of a word is the coefficient of
+// X^i) by reversing the bit order within each byte. Byte order is
+// unchanged: byte 0 is the low-degree byte in both conventions.
+func reflectBits(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i, b := range in {
+		out[i] = reverseByte(b)
+	}
+	return out
+}
+
+// unreflectBits is reflectBits' own inverse: reversing each byte's bits
+// twice is the identity.
+func unreflectBits(in []byte) []byte {
+	return reflectBits(in)
+}
+
+func reverseByte(b byte) byte {
+	b = b<<4 | b>>4
+	b = (b&0x33)<<2 | (b&0xcc)>>2
+	b = (b&0x55)<<1 | (b&0xaa)>>1
+	return b
+}
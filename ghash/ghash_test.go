@@ -0,0 +1,96 @@
+package ghash
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// TestGHASHEmptyInputIsZero covers NIST SP 800-38D test case 1: with no AAD
+// and no ciphertext, GHASH's only input is the all-zero length block, so
+// GHASH_H("") = (0 XOR 0) . H = 0 regardless of H.
+func TestGHASHEmptyInputIsZero(t *testing.T) {
+	var h [16]byte
+	copy(h[:], []byte("some subkey, any"))
+	g := NewGHASH(h)
+	g.Write(LengthBlock(0, 0))
+	got := g.Sum(nil)
+	if !bytes.Equal(got, make([]byte, BlockSize)) {
+		t.Fatalf("GHASH of empty AAD/ciphertext = %x, want all-zero block", got)
+	}
+}
+
+// TestGHASHKnownAnswerVector pins NIST SP 800-38D's GCM test case 2: H is
+// AES-128's encryption of the all-zero block under an all-zero key, and the
+// single ciphertext block is that test case's known ciphertext. No AAD is
+// present.
+func TestGHASHKnownAnswerVector(t *testing.T) {
+	var h [16]byte
+	copy(h[:], mustHex(t, "66e94bd4ef8a2c3b884cfa59ca342b2e"))
+	ciphertext := mustHex(t, "0388dace60b6a392f328c2b971b2fe78")
+	want := mustHex(t, "f38cbb1ad69223dcc3457ae5b6b0f885")
+
+	g := NewGHASH(h)
+	WriteBlock(g, nil)
+	WriteBlock(g, ciphertext)
+	g.Write(LengthBlock(0, uint64(len(ciphertext))*8))
+	got := g.Sum(nil)
+
+	if !bytes.Equal(got, want) {
+		t.Fatalf("GHASH = %x, want %x", got, want)
+	}
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestGHASHResetMatchesFresh(t *testing.T) {
+	var h [16]byte
+	copy(h[:], []byte("0123456789abcdef"))
+
+	g := NewGHASH(h)
+	WriteBlock(g, []byte("additional data"))
+	WriteBlock(g, []byte("some ciphertext!"))
+	g.Write(LengthBlock(16*8, 16*8))
+	first := g.Sum(nil)
+
+	g.Reset()
+	WriteBlock(g, []byte("additional data"))
+	WriteBlock(g, []byte("some ciphertext!"))
+	g.Write(LengthBlock(16*8, 16*8))
+	second := g.Sum(nil)
+
+	if !bytes.Equal(first, second) {
+		t.Fatalf("Reset produced a different digest: %x vs %x", first, second)
+	}
+}
+
+func TestGHASHWriteChunkingDoesNotMatter(t *testing.T) {
+	var h [16]byte
+	copy(h[:], []byte("0123456789abcdef"))
+	message := []byte("a message spanning more than one sixteen-byte block of input")
+
+	whole := NewGHASH(h)
+	WriteBlock(whole, message)
+	wantDigest := whole.Sum(nil)
+
+	chunked := NewGHASH(h)
+	padded := append([]byte{}, message...)
+	if rem := len(padded) % BlockSize; rem != 0 {
+		padded = append(padded, make([]byte, BlockSize-rem)...)
+	}
+	for _, b := range padded {
+		chunked.Write([]byte{b})
+	}
+	gotDigest := chunked.Sum(nil)
+
+	if !bytes.Equal(wantDigest, gotDigest) {
+		t.Fatalf("byte-at-a-time Write gave %x, want %x", gotDigest, wantDigest)
+	}
+}